@@ -0,0 +1,148 @@
+package converter
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/nikunjkothiya/gopdfconv/pkg/errors"
+	"github.com/pdfcpu/pdfcpu/pkg/api"
+	"github.com/pdfcpu/pdfcpu/pkg/pdfcpu"
+)
+
+// BatchOptions controls how ConvertBatch assembles its per-input PDFs into
+// one merged document.
+type BatchOptions struct {
+	// InsertBlankBetween inserts a blank page between each converted
+	// input, which pdfcpu also uses as a natural split point.
+	InsertBlankBetween bool
+	// AddBookmarkPerInput adds an outline bookmark pointing at the first
+	// page of each input's section.
+	AddBookmarkPerInput bool
+	// TOCTitle, if set, adds a top-level bookmark with this title over
+	// the whole merged document, above the per-input bookmarks.
+	TOCTitle string
+}
+
+// ConvertBatch converts each of inputs to PDF using the pool (so the same
+// worker/queue limits as single-file conversions apply), then merges the
+// results into a single PDF at outputPath in input order using pdfcpu, with
+// optional blank-page dividers and a bookmark per input.
+func (p *ConverterPool) ConvertBatch(ctx context.Context, inputs []string, outputPath string, opts BatchOptions) error {
+	if len(inputs) == 0 {
+		return errors.New(errors.ErrConversionFailed, "no inputs given to ConvertBatch")
+	}
+
+	parts, err := p.convertAll(ctx, inputs)
+	defer func() {
+		for _, part := range parts {
+			if part != "" {
+				os.Remove(part)
+			}
+		}
+	}()
+	if err != nil {
+		return err
+	}
+
+	if err := api.MergeCreateFile(parts, outputPath, opts.InsertBlankBetween, nil); err != nil {
+		return errors.Wrap(err, errors.ErrConversionFailed, "Failed to merge converted PDFs")
+	}
+
+	if opts.AddBookmarkPerInput || opts.TOCTitle != "" {
+		if err := addBookmarks(outputPath, inputs, parts, opts); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// convertAll submits every input to the pool and waits for all of them,
+// returning the converted PDF paths in the same order as inputs. On error
+// it still returns every temp output path created so far (some may hold
+// completed conversions, others may be empty placeholders), so the caller
+// can clean all of them up instead of leaking temp files. Crucially, it
+// waits on every job it managed to submit even after a later input fails
+// to queue: a worker that is already running a submitted job will still
+// write its output once convertAll has returned, so failing to wait for it
+// here would let the caller remove the temp file before the worker
+// recreates it.
+func (p *ConverterPool) convertAll(ctx context.Context, inputs []string) ([]string, error) {
+	parts := make([]string, len(inputs))
+	resultChs := make([]<-chan Result, len(inputs))
+
+	var firstErr error
+	for i, input := range inputs {
+		if firstErr != nil {
+			break
+		}
+
+		tmp, err := os.CreateTemp("", fmt.Sprintf("gopdfconv-batch-%d-*.pdf", i))
+		if err != nil {
+			firstErr = errors.Wrap(err, errors.ErrConversionFailed, "Failed to create temp output file")
+			break
+		}
+		tmp.Close()
+		parts[i] = tmp.Name()
+
+		resultCh, err := p.Submit(ctx, input, parts[i])
+		if err != nil {
+			firstErr = err
+			break
+		}
+		resultChs[i] = resultCh
+	}
+
+	for i, ch := range resultChs {
+		if ch == nil {
+			continue
+		}
+		if result := <-ch; result.Err != nil && firstErr == nil {
+			firstErr = errors.Wrap(result.Err, errors.ErrConversionFailed, fmt.Sprintf("Failed to convert input %q", inputs[i]))
+		}
+	}
+
+	return parts, firstErr
+}
+
+// addBookmarks writes an outline into outputPath: one bookmark per input
+// (titled after its filename) pointing at the first page of its section,
+// optionally nested under a top-level TOCTitle bookmark.
+func addBookmarks(outputPath string, inputs, parts []string, opts BatchOptions) error {
+	var bookmarks []pdfcpu.Bookmark
+
+	page := 1
+	for i := range inputs {
+		if opts.AddBookmarkPerInput {
+			bookmarks = append(bookmarks, pdfcpu.Bookmark{
+				Title:    filepath.Base(inputs[i]),
+				PageFrom: page,
+			})
+		}
+
+		n, err := api.PageCountFile(parts[i])
+		if err != nil {
+			return errors.Wrap(err, errors.ErrConversionFailed, "Failed to count pages for bookmark offsets")
+		}
+		page += n
+		if opts.InsertBlankBetween {
+			page++
+		}
+	}
+
+	if opts.TOCTitle != "" {
+		bookmarks = []pdfcpu.Bookmark{{Title: opts.TOCTitle, PageFrom: 1, Kids: bookmarks}}
+	}
+
+	if len(bookmarks) == 0 {
+		return nil
+	}
+
+	if err := api.AddBookmarksFile(outputPath, outputPath, bookmarks, true, nil); err != nil {
+		return errors.Wrap(err, errors.ErrConversionFailed, "Failed to add bookmarks")
+	}
+
+	return nil
+}
@@ -0,0 +1,50 @@
+package converter
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+// TestConvertAll_WaitsForInFlightJobOnPartialSubmitFailure guards against a
+// leak where convertAll returned as soon as a later Submit failed, without
+// waiting for an already-submitted job that was still running. That earlier
+// job's worker would write its output after the caller had already removed
+// (or never tracked) the temp file, leaking it permanently.
+func TestConvertAll_WaitsForInFlightJobOnPartialSubmitFailure(t *testing.T) {
+	p := &ConverterPool{
+		jobs:   make(chan job), // unbuffered: a send only succeeds once a worker is ready to receive
+		stopCh: make(chan struct{}),
+	}
+
+	const workDelay = 30 * time.Millisecond
+
+	ready := make(chan struct{})
+
+	// Stand in for a worker: take exactly one job, hold it for a bit (as a
+	// real soffice invocation would), then report success. It never loops
+	// back to take a second job, so the second input's Submit below is
+	// guaranteed to find nobody receiving. It signals ready immediately
+	// before parking on <-p.jobs, and convertAll isn't invoked until that
+	// signal is observed; convertAll also creates a temp file before its
+	// first Submit. That makes it very likely, though not guaranteed, that
+	// this goroutine has reached the receive by the time Submit runs.
+	go func() {
+		close(ready)
+		j := <-p.jobs
+		time.Sleep(workDelay)
+		j.resultCh <- Result{OutputPath: j.outputPath}
+	}()
+	<-ready
+
+	start := time.Now()
+	_, err := p.convertAll(context.Background(), []string{"a.docx", "b.docx"})
+	elapsed := time.Since(start)
+
+	if err == nil {
+		t.Fatal("expected convertAll to report the second input's queue-full Submit failure")
+	}
+	if elapsed < workDelay {
+		t.Fatalf("convertAll returned after %v, want it to block at least %v waiting on the already-submitted job", elapsed, workDelay)
+	}
+}
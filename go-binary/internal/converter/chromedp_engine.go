@@ -0,0 +1,88 @@
+package converter
+
+import (
+	"context"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/chromedp/cdproto/page"
+	"github.com/chromedp/chromedp"
+	"github.com/nikunjkothiya/gopdfconv/pkg/errors"
+)
+
+// ChromedpEngine renders HTML to PDF using headless Chrome, so unlike
+// WkhtmltopdfEngine it executes JavaScript before printing the page.
+type ChromedpEngine struct {
+	timeout time.Duration
+}
+
+// NewChromedpEngine creates a ChromedpEngine. timeout bounds how long a
+// single page load and print may take; pass 0 for a 30s default.
+func NewChromedpEngine(timeout time.Duration) *ChromedpEngine {
+	if timeout <= 0 {
+		timeout = 30 * time.Second
+	}
+	return &ChromedpEngine{timeout: timeout}
+}
+
+// Convert loads input (a local HTML file path or an http(s):// URL) in
+// headless Chrome, waits for it to settle, and prints it to a PDF at
+// outputPath.
+func (e *ChromedpEngine) Convert(input, outputPath string) error {
+	target := input
+	if !isURL(input) {
+		if _, err := os.Stat(input); os.IsNotExist(err) {
+			return errors.NewWithFile(errors.ErrFileNotFound, "File not found", input)
+		}
+		target = pathToFileURL(input)
+	}
+
+	ctx, cancel := chromedp.NewContext(context.Background())
+	defer cancel()
+
+	ctx, timeoutCancel := context.WithTimeout(ctx, e.timeout)
+	defer timeoutCancel()
+
+	var pdfData []byte
+	err := chromedp.Run(ctx,
+		chromedp.Navigate(target),
+		chromedp.ActionFunc(func(ctx context.Context) error {
+			var err error
+			pdfData, _, err = page.PrintToPDF().WithPrintBackground(true).Do(ctx)
+			return err
+		}),
+	)
+	if err != nil {
+		return errors.NewWithDetails(errors.ErrConversionFailed, "chromedp conversion failed", input, err.Error())
+	}
+
+	if err := os.WriteFile(outputPath, pdfData, 0644); err != nil {
+		return errors.Wrap(err, errors.ErrWriteFailed, "Failed to write PDF output")
+	}
+
+	return nil
+}
+
+// ConvertTo is provided to satisfy the Engine interface. chromedp only ever
+// produces PDF output, so format is ignored.
+func (e *ChromedpEngine) ConvertTo(input, outputPath, format string) error {
+	return e.Convert(input, outputPath)
+}
+
+// Supports reports whether chromedp should handle the given extension.
+func (e *ChromedpEngine) Supports(ext string) bool {
+	switch strings.ToLower(ext) {
+	case ".html", ".htm":
+		return true
+	default:
+		return false
+	}
+}
+
+// Name identifies this engine as "chromedp".
+func (e *ChromedpEngine) Name() string {
+	return "chromedp"
+}
+
+var _ Engine = (*ChromedpEngine)(nil)
@@ -0,0 +1,130 @@
+package converter
+
+import (
+	"os/exec"
+	"regexp"
+	"runtime"
+
+	"github.com/nikunjkothiya/gopdfconv/pkg/errors"
+)
+
+// candidatePaths lists the well-known install locations for LibreOffice,
+// checked in order before falling back to PATH. This mirrors how Go's
+// makerelease tool probes toolchains per-GOOS: a short, explicit list of
+// "where distros/vendors actually put this" rather than a clever search.
+func candidatePaths() []string {
+	switch runtime.GOOS {
+	case "darwin":
+		return []string{
+			"/Applications/LibreOffice.app/Contents/MacOS/soffice",
+		}
+	case "windows":
+		return []string{
+			`C:\Program Files\LibreOffice\program\soffice.exe`,
+			`C:\Program Files (x86)\LibreOffice\program\soffice.exe`,
+		}
+	default:
+		return []string{
+			"/usr/bin/libreoffice",
+			"/usr/bin/soffice",
+			"/snap/bin/libreoffice",
+			"/var/lib/flatpak/exports/bin/org.libreoffice.LibreOffice",
+		}
+	}
+}
+
+var versionPattern = regexp.MustCompile(`(\d+)\.(\d+)`)
+
+// fullVersionPattern matches a whole dotted version such as "7.5.9.2".
+var fullVersionPattern = regexp.MustCompile(`\d+(?:\.\d+)+`)
+
+// DiscoverLibreOffice searches platform-specific install locations, then
+// PATH, for a working LibreOffice/soffice binary, and returns its path
+// along with the version reported by `soffice --version`.
+func DiscoverLibreOffice() (path, version string, err error) {
+	candidates := candidatePaths()
+	if found, lookErr := exec.LookPath("soffice"); lookErr == nil {
+		candidates = append(candidates, found)
+	}
+	if found, lookErr := exec.LookPath("libreoffice"); lookErr == nil {
+		candidates = append(candidates, found)
+	}
+
+	for _, candidate := range candidates {
+		v, probeErr := probeVersion(candidate)
+		if probeErr == nil {
+			return candidate, v, nil
+		}
+	}
+
+	return "", "", errors.New(errors.ErrConversionFailed, "no LibreOffice installation found")
+}
+
+// probeVersion runs `soffice --version` and extracts the version string
+// (e.g. "7.5.9.2") from its output.
+func probeVersion(path string) (string, error) {
+	output, err := exec.Command(path, "--version").CombinedOutput()
+	if err != nil {
+		return "", errors.NewWithFile(errors.ErrConversionFailed, "failed to run --version", path)
+	}
+
+	match := fullVersionPattern.FindString(string(output))
+	if match == "" {
+		return "", errors.NewWithFile(errors.ErrConversionFailed, "could not parse LibreOffice version", path)
+	}
+
+	return match, nil
+}
+
+// version lazily probes and caches this converter's LibreOffice version.
+func (c *LibreOfficeConverter) version() (string, error) {
+	if c.cachedVersion == "" {
+		v, err := probeVersion(c.libreOfficePath)
+		if err != nil {
+			return "", err
+		}
+		c.cachedVersion = v
+	}
+	return c.cachedVersion, nil
+}
+
+// RequireMinVersion fails fast if this converter's LibreOffice build is
+// older than major.minor, so callers can surface a clear error instead of
+// a filter-not-found failure deep inside a conversion (e.g. PDF/A-2b
+// support requires LibreOffice 6.0+).
+func (c *LibreOfficeConverter) RequireMinVersion(major, minor int) error {
+	v, err := c.version()
+	if err != nil {
+		return err
+	}
+
+	gotMajor, gotMinor, err := parseVersion(v)
+	if err != nil {
+		return err
+	}
+
+	if gotMajor > major || (gotMajor == major && gotMinor >= minor) {
+		return nil
+	}
+
+	return errors.NewWithDetails(errors.ErrConversionFailed, "LibreOffice version too old", c.libreOfficePath, v)
+}
+
+func parseVersion(v string) (major, minor int, err error) {
+	parts := versionPattern.FindStringSubmatch(v)
+	if len(parts) != 3 {
+		return 0, 0, errors.New(errors.ErrConversionFailed, "malformed LibreOffice version string")
+	}
+
+	major = atoi(parts[1])
+	minor = atoi(parts[2])
+	return major, minor, nil
+}
+
+func atoi(s string) int {
+	n := 0
+	for _, r := range s {
+		n = n*10 + int(r-'0')
+	}
+	return n
+}
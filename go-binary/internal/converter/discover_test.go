@@ -0,0 +1,48 @@
+package converter
+
+import "testing"
+
+func TestParseVersion(t *testing.T) {
+	cases := []struct {
+		in        string
+		wantMajor int
+		wantMinor int
+		wantErr   bool
+	}{
+		{"7.5", 7, 5, false},
+		{"7.5.9.2", 7, 5, false},
+		{"LibreOffice 7.5.9.2 40(Build:2)", 7, 5, false},
+		{"not a version", 0, 0, true},
+	}
+
+	for _, tc := range cases {
+		major, minor, err := parseVersion(tc.in)
+		if tc.wantErr {
+			if err == nil {
+				t.Errorf("parseVersion(%q) = (%d, %d, nil), want an error", tc.in, major, minor)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("parseVersion(%q) returned error: %v", tc.in, err)
+			continue
+		}
+		if major != tc.wantMajor || minor != tc.wantMinor {
+			t.Errorf("parseVersion(%q) = (%d, %d), want (%d, %d)", tc.in, major, minor, tc.wantMajor, tc.wantMinor)
+		}
+	}
+}
+
+func TestAtoi(t *testing.T) {
+	cases := map[string]int{
+		"0":  0,
+		"7":  7,
+		"42": 42,
+	}
+
+	for in, want := range cases {
+		if got := atoi(in); got != want {
+			t.Errorf("atoi(%q) = %d, want %d", in, got, want)
+		}
+	}
+}
@@ -0,0 +1,36 @@
+package converter
+
+import "strings"
+
+// Engine is implemented by every conversion backend (LibreOffice, pandoc,
+// wkhtmltopdf, chromedp, ...). Router picks between them so callers of the
+// top-level Convert API don't have to know which backend a given extension
+// needs.
+type Engine interface {
+	// Convert produces a PDF from inputPath at outputPath.
+	Convert(inputPath, outputPath string) error
+	// ConvertTo converts inputPath to outputPath in the given target format.
+	ConvertTo(inputPath, outputPath, format string) error
+	// Supports reports whether this engine can handle the given file
+	// extension (including the leading dot, e.g. ".docx").
+	Supports(ext string) bool
+	// Name identifies the engine for logging and Router preference lists.
+	Name() string
+}
+
+// Supports reports whether LibreOffice can convert the given extension.
+func (c *LibreOfficeConverter) Supports(ext string) bool {
+	switch strings.ToLower(ext) {
+	case ".doc", ".docx", ".odt", ".ppt", ".pptx", ".odp", ".xls", ".xlsx", ".ods", ".rtf", ".txt":
+		return true
+	default:
+		return false
+	}
+}
+
+// Name identifies this engine as "libreoffice".
+func (c *LibreOfficeConverter) Name() string {
+	return "libreoffice"
+}
+
+var _ Engine = (*LibreOfficeConverter)(nil)
@@ -0,0 +1,84 @@
+// Package httpx wires the converter package's streaming Convert API
+// directly to HTTP multipart uploads, so handlers don't need to persist
+// uploads to disk before invoking LibreOffice.
+package httpx
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"path/filepath"
+
+	"github.com/nikunjkothiya/gopdfconv/internal/converter"
+)
+
+// maxUploadSize bounds how large a single multipart upload may be.
+const maxUploadSize = 32 << 20 // 32MB
+
+// Handler adapts ConverterPool.ConvertStream to an http.Handler, accepting
+// a multipart "file" field and streaming back the converted PDF. It goes
+// through the pool rather than a bare LibreOfficeConverter so many
+// concurrent uploads fan in through the same MaxWorkers/QueueDepth bounds
+// as any other caller of the pool, instead of each request spawning its
+// own unbounded soffice process.
+type Handler struct {
+	pool *converter.ConverterPool
+}
+
+// NewHandler creates a Handler that converts uploads via pool.
+func NewHandler(pool *converter.ConverterPool) *Handler {
+	return &Handler{pool: pool}
+}
+
+type errorEnvelope struct {
+	Error string `json:"error"`
+}
+
+func writeError(w http.ResponseWriter, status int, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(errorEnvelope{Error: message})
+}
+
+func setCORSHeaders(w http.ResponseWriter) {
+	w.Header().Set("Access-Control-Allow-Origin", "*")
+	w.Header().Set("Access-Control-Allow-Methods", "POST, OPTIONS")
+	w.Header().Set("Access-Control-Allow-Headers", "Content-Type")
+}
+
+// ServeHTTP handles POST requests with a multipart "file" field, converts
+// it to PDF, and streams the result back as application/pdf.
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	setCORSHeaders(w)
+
+	if r.Method == http.MethodOptions {
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	if r.Method != http.MethodPost {
+		writeError(w, http.StatusMethodNotAllowed, "only POST is supported")
+		return
+	}
+
+	r.Body = http.MaxBytesReader(w, r.Body, maxUploadSize)
+	file, header, err := r.FormFile("file")
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "missing \"file\" form field")
+		return
+	}
+	defer file.Close()
+
+	ext := filepath.Ext(header.Filename)
+
+	result, err := h.pool.ConvertStream(r.Context(), file, ext, converter.PDFOptions{})
+	if err != nil {
+		writeError(w, http.StatusUnprocessableEntity, err.Error())
+		return
+	}
+	defer result.Close()
+
+	w.Header().Set("Content-Type", "application/pdf")
+	w.WriteHeader(http.StatusOK)
+	io.Copy(w, result)
+}
@@ -1,6 +1,7 @@
 package converter
 
 import (
+	"context"
 	"os"
 	"os/exec"
 	"path/filepath"
@@ -13,6 +14,7 @@ import (
 // LibreOfficeConverter handles conversion using LibreOffice
 type LibreOfficeConverter struct {
 	libreOfficePath string
+	cachedVersion   string
 }
 
 // NewLibreOfficeConverter creates a new LibreOffice converter
@@ -37,8 +39,25 @@ func pathToFileURL(path string) string {
 	return "file://" + path
 }
 
-// Convert performs the conversion using LibreOffice
+// Convert performs the conversion using LibreOffice with default PDF
+// options.
 func (c *LibreOfficeConverter) Convert(inputPath, outputPath string) error {
+	return c.ConvertWithOptions(inputPath, outputPath, PDFOptions{})
+}
+
+// ConvertWithOptions performs the conversion using LibreOffice, applying
+// opts as FilterData on the export filter (page range, PDF/A conformance,
+// encryption, watermark, etc.).
+func (c *LibreOfficeConverter) ConvertWithOptions(inputPath, outputPath string, opts PDFOptions) error {
+	return c.ConvertWithOptionsContext(context.Background(), inputPath, outputPath, opts)
+}
+
+// ConvertWithOptionsContext is ConvertWithOptions with a caller-supplied
+// context: cancelling ctx (or letting its deadline pass) kills the
+// underlying soffice process via exec.CommandContext instead of merely
+// abandoning it, so callers like ConverterPool's PerJobTimeout actually
+// bound the subprocess's lifetime.
+func (c *LibreOfficeConverter) ConvertWithOptionsContext(ctx context.Context, inputPath, outputPath string, opts PDFOptions) error {
 	// Check if file exists
 	if _, err := os.Stat(inputPath); os.IsNotExist(err) {
 		return errors.NewWithFile(errors.ErrFileNotFound, "File not found", inputPath)
@@ -70,19 +89,15 @@ func (c *LibreOfficeConverter) Convert(inputPath, outputPath string) error {
 	// Build user installation URL for temp profile
 	userInstallURL := pathToFileURL(profileDir)
 
-	// Detect file type for proper filter
-	ext := strings.ToLower(filepath.Ext(inputPath))
-	convertFilter := "pdf"
-	if ext == ".pptx" || ext == ".ppt" || ext == ".odp" {
-		convertFilter = "pdf:impress_pdf_Export"
-	} else if ext == ".xlsx" || ext == ".xls" || ext == ".ods" {
-		convertFilter = "pdf:calc_pdf_Export"
-	} else if ext == ".docx" || ext == ".doc" || ext == ".odt" {
-		convertFilter = "pdf:writer_pdf_Export"
-	}
+	// Detect file type and build the export filter, including FilterData
+	// for any requested PDFOptions
+	ext := filepath.Ext(inputPath)
+	convertFilter := filter(ext, opts)
 
-	// Run LibreOffice conversion with a fresh temporary user profile
-	cmd := exec.Command(c.libreOfficePath,
+	// Run LibreOffice conversion with a fresh temporary user profile.
+	// exec.CommandContext kills the process if ctx is cancelled or its
+	// deadline passes, instead of leaving an orphaned soffice running.
+	cmd := exec.CommandContext(ctx, c.libreOfficePath,
 		"-env:UserInstallation="+userInstallURL,
 		"--headless",
 		"--invisible",
@@ -130,8 +145,22 @@ func (c *LibreOfficeConverter) Convert(inputPath, outputPath string) error {
 	return nil
 }
 
-// ConvertTo converts a file to a specific format using LibreOffice
+// ConvertTo converts a file to a specific format using LibreOffice.
 func (c *LibreOfficeConverter) ConvertTo(inputPath, outputPath, format string) error {
+	return c.ConvertToWithOptions(inputPath, outputPath, format, PDFOptions{})
+}
+
+// ConvertToWithOptions converts a file to a specific format using
+// LibreOffice, applying opts' FilterData when format targets a PDF export
+// filter (e.g. "pdf" or "pdf:writer_pdf_Export"); opts are ignored for
+// non-PDF formats.
+func (c *LibreOfficeConverter) ConvertToWithOptions(inputPath, outputPath, format string, opts PDFOptions) error {
+	if strings.HasPrefix(format, "pdf") {
+		if data := opts.filterData(); data != "" {
+			format = format + ":" + data
+		}
+	}
+
 	tempDir, err := os.MkdirTemp("", "gopdfconv-lo-*")
 	if err != nil {
 		return errors.Wrap(err, errors.ErrConversionFailed, "Failed to create temp directory")
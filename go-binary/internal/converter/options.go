@@ -0,0 +1,183 @@
+package converter
+
+import (
+	"fmt"
+	"strings"
+)
+
+// PDFOptions controls the LibreOffice "pdf" filter's FilterData, letting
+// callers request things the bare filter name can't express: page ranges,
+// image quality, PDF/A conformance, tagging, encryption, and an initial
+// view. Zero-value PDFOptions reproduces the previous untuned behaviour.
+type PDFOptions struct {
+	// PageRange is a LibreOffice page range string, e.g. "1-3,5".
+	PageRange string
+
+	// Quality is the JPEG compression quality (1-100) applied to embedded
+	// images; 0 leaves LibreOffice's default.
+	Quality int
+	// ReduceImageDPI downsamples embedded images to this DPI; 0 disables
+	// downsampling.
+	ReduceImageDPI int
+
+	// Conformance selects a PDF/A profile: "PDF/A-1b" or "PDF/A-2b". Empty
+	// disables PDF/A conformance.
+	Conformance string
+	// Tagged requests a tagged (accessible) PDF.
+	Tagged bool
+
+	// OwnerPassword and UserPassword, if set, enable encryption.
+	OwnerPassword string
+	UserPassword  string
+	// AllowPrinting and AllowCopying only apply when encryption is enabled.
+	AllowPrinting bool
+	AllowCopying  bool
+
+	// WatermarkText, if set, is stamped across every page.
+	WatermarkText string
+
+	// InitialView selects which navigation panel opens alongside the page:
+	// "PageOnly", "Bookmarks", or "Thumbnails". Empty leaves LibreOffice's
+	// default.
+	InitialView string
+	// Magnification selects the opening zoom mode: "FitInWindow",
+	// "FitWidth", "FitVisible", or "Zoom" (with InitialZoom supplying the
+	// percentage). Empty leaves LibreOffice's default.
+	Magnification string
+	// InitialZoom is the opening zoom percentage, used when Magnification
+	// is "Zoom"; ignored otherwise.
+	InitialZoom int
+
+	// EmbedStandardFonts forces embedding of the 14 standard PDF fonts
+	// instead of relying on viewer substitution.
+	EmbedStandardFonts bool
+}
+
+// filterData renders opts as the JSON object LibreOffice expects after the
+// filter name, e.g. pdf:writer_pdf_Export:{"SelectPdfVersion":...}. It
+// returns "" when opts is the zero value, so filter() falls back to the
+// plain filter name.
+func (o PDFOptions) filterData() string {
+	var fields []string
+
+	add := func(name, typ, value string) {
+		fields = append(fields, fmt.Sprintf(`"%s":{"type":"%s","value":%s}`, name, typ, value))
+	}
+
+	switch o.Conformance {
+	case "PDF/A-1b":
+		add("SelectPdfVersion", "long", `"1"`)
+	case "PDF/A-2b":
+		add("SelectPdfVersion", "long", `"2"`)
+	}
+
+	if o.Tagged {
+		add("UseTaggedPDF", "boolean", "true")
+	}
+
+	if o.PageRange != "" {
+		add("PageRange", "string", fmt.Sprintf("%q", o.PageRange))
+	}
+
+	if o.Quality > 0 {
+		add("Quality", "long", fmt.Sprintf(`"%d"`, o.Quality))
+	}
+	if o.ReduceImageDPI > 0 {
+		add("ReduceImageResolution", "boolean", "true")
+		add("MaxImageResolution", "long", fmt.Sprintf(`"%d"`, o.ReduceImageDPI))
+	}
+
+	if o.OwnerPassword != "" || o.UserPassword != "" {
+		add("EncryptFile", "boolean", "true")
+		if o.UserPassword != "" {
+			// DocumentOpenPassword is the password required just to open
+			// the file at all.
+			add("DocumentOpenPassword", "string", fmt.Sprintf("%q", o.UserPassword))
+		}
+		if o.OwnerPassword != "" {
+			// RestrictPermissions gates permission changes (printing,
+			// copying, ...) behind PermissionPassword, the owner password.
+			add("RestrictPermissions", "boolean", "true")
+			add("PermissionPassword", "string", fmt.Sprintf("%q", o.OwnerPassword))
+		}
+		add("Printing", "long", boolToPermission(o.AllowPrinting))
+		add("EnableCopyingOfContent", "boolean", fmt.Sprintf("%t", o.AllowCopying))
+	}
+
+	if o.WatermarkText != "" {
+		add("Watermark", "string", fmt.Sprintf("%q", o.WatermarkText))
+	}
+
+	if v, ok := initialViewValues[o.InitialView]; ok {
+		add("InitialView", "long", fmt.Sprintf(`"%d"`, v))
+	}
+	if v, ok := magnificationValues[o.Magnification]; ok {
+		add("Magnification", "long", fmt.Sprintf(`"%d"`, v))
+		if o.Magnification == "Zoom" && o.InitialZoom > 0 {
+			add("Zoom", "long", fmt.Sprintf(`"%d"`, o.InitialZoom))
+		}
+	}
+
+	if o.EmbedStandardFonts {
+		add("EmbedStandardFonts", "boolean", "true")
+	}
+
+	if len(fields) == 0 {
+		return ""
+	}
+
+	return "{" + strings.Join(fields, ",") + "}"
+}
+
+// initialViewValues maps InitialView to LibreOffice's navigation-panel
+// enum: 0 = page only, 1 = outline/bookmarks, 2 = thumbnails.
+var initialViewValues = map[string]int{
+	"PageOnly":   0,
+	"Bookmarks":  1,
+	"Thumbnails": 2,
+}
+
+// magnificationValues maps Magnification to LibreOffice's zoom-mode enum;
+// Zoom (4) is the only mode that reads InitialZoom.
+var magnificationValues = map[string]int{
+	"FitInWindow": 1,
+	"FitWidth":    2,
+	"FitVisible":  3,
+	"Zoom":        4,
+}
+
+// boolToPermission renders LibreOffice's permission enum: 0 = not allowed,
+// 1 = low resolution/limited, 2 = high resolution/full.
+func boolToPermission(allowed bool) string {
+	if allowed {
+		return `"2"`
+	}
+	return `"0"`
+}
+
+// filter builds the full --convert-to filter string for ext, appending
+// opts' FilterData when it requests anything beyond the plain export
+// filter.
+func filter(ext string, opts PDFOptions) string {
+	base := baseFilter(ext)
+
+	data := opts.filterData()
+	if data == "" {
+		return base
+	}
+
+	return base + ":" + data
+}
+
+func baseFilter(ext string) string {
+	switch strings.ToLower(ext) {
+	case ".pptx", ".ppt", ".odp":
+		return "pdf:impress_pdf_Export"
+	case ".xlsx", ".xls", ".ods":
+		return "pdf:calc_pdf_Export"
+	case ".docx", ".doc", ".odt":
+		return "pdf:writer_pdf_Export"
+	default:
+		return "pdf"
+	}
+}
@@ -0,0 +1,75 @@
+package converter
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestPDFOptions_FilterData_Empty(t *testing.T) {
+	if got := (PDFOptions{}).filterData(); got != "" {
+		t.Fatalf("filterData() = %q, want empty string for zero-value PDFOptions", got)
+	}
+}
+
+func TestPDFOptions_FilterData_Encryption(t *testing.T) {
+	opts := PDFOptions{
+		OwnerPassword: "ownerpw",
+		UserPassword:  "userpw",
+		AllowPrinting: true,
+		AllowCopying:  false,
+	}
+
+	data := opts.filterData()
+
+	// UserPassword gates opening the file at all.
+	if !strings.Contains(data, `"DocumentOpenPassword":{"type":"string","value":"userpw"}`) {
+		t.Errorf("filterData() = %s, want UserPassword in DocumentOpenPassword", data)
+	}
+	// OwnerPassword gates permission changes.
+	if !strings.Contains(data, `"PermissionPassword":{"type":"string","value":"ownerpw"}`) {
+		t.Errorf("filterData() = %s, want OwnerPassword in PermissionPassword", data)
+	}
+	if !strings.Contains(data, `"RestrictPermissions":{"type":"boolean","value":true}`) {
+		t.Errorf("filterData() = %s, want RestrictPermissions enabled", data)
+	}
+	// AllowPrinting true -> full (2); AllowCopying is its own boolean.
+	if !strings.Contains(data, `"Printing":{"type":"long","value":"2"}`) {
+		t.Errorf("filterData() = %s, want Printing=2 for AllowPrinting=true", data)
+	}
+	if !strings.Contains(data, `"EnableCopyingOfContent":{"type":"boolean","value":false}`) {
+		t.Errorf("filterData() = %s, want EnableCopyingOfContent=false for AllowCopying=false", data)
+	}
+}
+
+func TestPDFOptions_FilterData_InitialViewAndMagnification(t *testing.T) {
+	opts := PDFOptions{
+		InitialView:   "Bookmarks",
+		Magnification: "Zoom",
+		InitialZoom:   150,
+	}
+
+	data := opts.filterData()
+
+	if !strings.Contains(data, `"InitialView":{"type":"long","value":"1"}`) {
+		t.Errorf("filterData() = %s, want InitialView=1 for Bookmarks", data)
+	}
+	if !strings.Contains(data, `"Magnification":{"type":"long","value":"4"}`) {
+		t.Errorf("filterData() = %s, want Magnification=4 for Zoom", data)
+	}
+	if !strings.Contains(data, `"Zoom":{"type":"long","value":"150"}`) {
+		t.Errorf("filterData() = %s, want Zoom=150", data)
+	}
+}
+
+func TestPDFOptions_FilterData_ZoomIgnoredWithoutZoomMagnification(t *testing.T) {
+	opts := PDFOptions{
+		Magnification: "FitInWindow",
+		InitialZoom:   150,
+	}
+
+	data := opts.filterData()
+
+	if strings.Contains(data, `"Zoom"`) {
+		t.Errorf("filterData() = %s, want no Zoom field when Magnification != \"Zoom\"", data)
+	}
+}
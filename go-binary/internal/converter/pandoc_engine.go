@@ -0,0 +1,70 @@
+package converter
+
+import (
+	"os"
+	"os/exec"
+	"strings"
+
+	"github.com/nikunjkothiya/gopdfconv/pkg/errors"
+)
+
+// PandocEngine converts lightweight markup formats to PDF via pandoc,
+// rendering through xelatex so the output handles unicode and custom fonts.
+type PandocEngine struct {
+	pandocPath string
+}
+
+// NewPandocEngine creates a PandocEngine that invokes the pandoc binary at
+// pandocPath (e.g. "pandoc" to rely on PATH).
+func NewPandocEngine(pandocPath string) *PandocEngine {
+	return &PandocEngine{pandocPath: pandocPath}
+}
+
+// Convert renders inputPath to a PDF at outputPath using pandoc's xelatex
+// PDF engine.
+func (e *PandocEngine) Convert(inputPath, outputPath string) error {
+	if _, err := os.Stat(inputPath); os.IsNotExist(err) {
+		return errors.NewWithFile(errors.ErrFileNotFound, "File not found", inputPath)
+	}
+
+	cmd := exec.Command(e.pandocPath, "--pdf-engine=xelatex", "-o", outputPath, inputPath)
+	output, err := cmd.CombinedOutput()
+	if err != nil {
+		return errors.NewWithDetails(errors.ErrConversionFailed, "pandoc conversion failed", inputPath, string(output))
+	}
+
+	return nil
+}
+
+// ConvertTo renders inputPath to outputPath using pandoc's writer for
+// format (e.g. "html", "docx") instead of the PDF engine.
+func (e *PandocEngine) ConvertTo(inputPath, outputPath, format string) error {
+	if _, err := os.Stat(inputPath); os.IsNotExist(err) {
+		return errors.NewWithFile(errors.ErrFileNotFound, "File not found", inputPath)
+	}
+
+	cmd := exec.Command(e.pandocPath, "-t", format, "-o", outputPath, inputPath)
+	output, err := cmd.CombinedOutput()
+	if err != nil {
+		return errors.NewWithDetails(errors.ErrConversionFailed, "pandoc conversion failed", inputPath, string(output))
+	}
+
+	return nil
+}
+
+// Supports reports whether pandoc should handle the given extension.
+func (e *PandocEngine) Supports(ext string) bool {
+	switch strings.ToLower(ext) {
+	case ".md", ".markdown", ".rst", ".tex", ".html", ".htm":
+		return true
+	default:
+		return false
+	}
+}
+
+// Name identifies this engine as "pandoc".
+func (e *PandocEngine) Name() string {
+	return "pandoc"
+}
+
+var _ Engine = (*PandocEngine)(nil)
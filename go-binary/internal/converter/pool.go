@@ -0,0 +1,192 @@
+package converter
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/nikunjkothiya/gopdfconv/pkg/errors"
+)
+
+// Result is delivered on the channel returned by ConverterPool.Submit once a
+// queued job has finished (successfully or not).
+type Result struct {
+	OutputPath string
+	Err        error
+}
+
+// Stats is a point-in-time snapshot of ConverterPool activity, suitable for
+// exposing on a health/metrics endpoint.
+type Stats struct {
+	QueueLength int
+	InFlight    int
+	Completed   int64
+	Failures    int64
+}
+
+// job is a single queued conversion request.
+type job struct {
+	ctx        context.Context
+	inputPath  string
+	outputPath string
+	opts       PDFOptions
+	resultCh   chan Result
+}
+
+// ConverterPool wraps a LibreOfficeConverter with a bounded worker pool so
+// many uploads can be converted concurrently without racing on
+// --env:UserInstallation. LibreOffice serializes on its user profile, so
+// each job already gets a unique profile directory from LibreOfficeConverter
+// itself; the pool's job is purely to bound how many soffice processes run
+// at once and how many jobs can wait behind them.
+type ConverterPool struct {
+	converter     *LibreOfficeConverter
+	maxWorkers    int
+	queueDepth    int
+	perJobTimeout time.Duration
+
+	jobs   chan job
+	stopCh chan struct{}
+	wg     sync.WaitGroup
+
+	// submitMu serializes Close against in-flight Submits so Close can
+	// safely close p.jobs: it takes the write lock before closing, which
+	// guarantees no Submit is concurrently sending on (and would panic on)
+	// the now-closed channel.
+	submitMu  sync.RWMutex
+	closeOnce sync.Once
+
+	inFlight  int32
+	completed int64
+	failures  int64
+}
+
+// NewConverterPool creates a ConverterPool around conv and immediately
+// starts maxWorkers background workers, analogous to moredoc's
+// loopConvertDocument pattern. queueDepth bounds how many pending jobs may
+// wait for a free worker before Submit starts rejecting new work.
+// perJobTimeout bounds how long any single conversion may run; pass 0 to
+// disable the timeout.
+func NewConverterPool(conv *LibreOfficeConverter, maxWorkers, queueDepth int, perJobTimeout time.Duration) *ConverterPool {
+	if maxWorkers <= 0 {
+		maxWorkers = 1
+	}
+	if queueDepth < 0 {
+		queueDepth = 0
+	}
+
+	p := &ConverterPool{
+		converter:     conv,
+		maxWorkers:    maxWorkers,
+		queueDepth:    queueDepth,
+		perJobTimeout: perJobTimeout,
+		jobs:          make(chan job, queueDepth),
+		stopCh:        make(chan struct{}),
+	}
+
+	for i := 0; i < maxWorkers; i++ {
+		p.wg.Add(1)
+		go p.loopConvertDocument()
+	}
+
+	return p
+}
+
+// Submit enqueues a conversion job and returns a channel that receives
+// exactly one Result once the job completes. It does not block waiting for
+// a free worker: if the queue is already full, it returns
+// errors.ErrConversionFailed immediately so callers can apply backpressure
+// (e.g. a 503 to the uploader) instead of piling up in memory.
+func (p *ConverterPool) Submit(ctx context.Context, inputPath, outputPath string) (<-chan Result, error) {
+	return p.SubmitWithOptions(ctx, inputPath, outputPath, PDFOptions{})
+}
+
+// SubmitWithOptions is Submit with PDFOptions applied to the conversion
+// (page range, PDF/A conformance, encryption, watermark, etc.).
+func (p *ConverterPool) SubmitWithOptions(ctx context.Context, inputPath, outputPath string, opts PDFOptions) (<-chan Result, error) {
+	p.submitMu.RLock()
+	defer p.submitMu.RUnlock()
+
+	resultCh := make(chan Result, 1)
+
+	select {
+	case <-p.stopCh:
+		return nil, errors.New(errors.ErrConversionFailed, "converter pool is shut down")
+	default:
+	}
+
+	j := job{ctx: ctx, inputPath: inputPath, outputPath: outputPath, opts: opts, resultCh: resultCh}
+
+	select {
+	case p.jobs <- j:
+		return resultCh, nil
+	default:
+		return nil, errors.NewWithFile(errors.ErrConversionFailed, "conversion queue is full", inputPath)
+	}
+}
+
+// loopConvertDocument is the per-worker goroutine body: it pulls jobs off
+// the shared queue until Close has closed it and every already-queued job
+// has been drained, converting one document at a time so LibreOffice never
+// sees two invocations from the same worker. It deliberately ignores stopCh:
+// honoring it directly here would let a worker exit while jobs still sit in
+// the buffered queue, stranding their callers on <-resultCh forever.
+func (p *ConverterPool) loopConvertDocument() {
+	defer p.wg.Done()
+
+	for j := range p.jobs {
+		p.runJob(j)
+	}
+}
+
+func (p *ConverterPool) runJob(j job) {
+	atomic.AddInt32(&p.inFlight, 1)
+	defer atomic.AddInt32(&p.inFlight, -1)
+
+	ctx := j.ctx
+	var cancel context.CancelFunc
+	if p.perJobTimeout > 0 {
+		ctx, cancel = context.WithTimeout(ctx, p.perJobTimeout)
+		defer cancel()
+	}
+
+	// ConvertWithOptionsContext runs soffice via exec.CommandContext, so
+	// when ctx's deadline passes this returns promptly with the process
+	// killed rather than leaving it running in the background.
+	err := p.converter.ConvertWithOptionsContext(ctx, j.inputPath, j.outputPath, j.opts)
+	if err != nil && ctx.Err() != nil {
+		err = errors.NewWithFile(errors.ErrConversionFailed, "conversion timed out", j.inputPath)
+	}
+
+	if err != nil {
+		atomic.AddInt64(&p.failures, 1)
+	} else {
+		atomic.AddInt64(&p.completed, 1)
+	}
+
+	j.resultCh <- Result{OutputPath: j.outputPath, Err: err}
+}
+
+// Stats returns a snapshot of the pool's current queue length, in-flight
+// job count, and lifetime completion/failure counts.
+func (p *ConverterPool) Stats() Stats {
+	return Stats{
+		QueueLength: len(p.jobs),
+		InFlight:    int(atomic.LoadInt32(&p.inFlight)),
+		Completed:   atomic.LoadInt64(&p.completed),
+		Failures:    atomic.LoadInt64(&p.failures),
+	}
+}
+
+// Close stops accepting new work and waits for every job already queued or
+// in-flight to finish before returning.
+func (p *ConverterPool) Close() {
+	p.closeOnce.Do(func() {
+		p.submitMu.Lock()
+		close(p.stopCh)
+		close(p.jobs)
+		p.submitMu.Unlock()
+	})
+	p.wg.Wait()
+}
@@ -0,0 +1,46 @@
+package converter
+
+import (
+	"context"
+	"testing"
+)
+
+func TestConverterPool_SubmitQueueFull(t *testing.T) {
+	p := &ConverterPool{
+		jobs:   make(chan job), // unbuffered: no worker is draining it
+		stopCh: make(chan struct{}),
+	}
+
+	if _, err := p.Submit(context.Background(), "in.docx", "out.pdf"); err == nil {
+		t.Fatal("expected Submit to fail when the queue has no room and nothing is draining it")
+	}
+}
+
+func TestConverterPool_SubmitAfterClose(t *testing.T) {
+	p := &ConverterPool{
+		jobs:   make(chan job, 1),
+		stopCh: make(chan struct{}),
+	}
+	close(p.stopCh)
+
+	if _, err := p.Submit(context.Background(), "in.docx", "out.pdf"); err == nil {
+		t.Fatal("expected Submit to fail once the pool is shut down")
+	}
+}
+
+func TestConverterPool_Stats(t *testing.T) {
+	p := &ConverterPool{
+		jobs:   make(chan job, 2),
+		stopCh: make(chan struct{}),
+	}
+
+	p.jobs <- job{}
+	stats := p.Stats()
+
+	if stats.QueueLength != 1 {
+		t.Fatalf("QueueLength = %d, want 1", stats.QueueLength)
+	}
+	if stats.InFlight != 0 || stats.Completed != 0 || stats.Failures != 0 {
+		t.Fatalf("unexpected non-zero stats on a fresh pool: %+v", stats)
+	}
+}
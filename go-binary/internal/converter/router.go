@@ -0,0 +1,66 @@
+package converter
+
+import (
+	"path/filepath"
+	"strings"
+
+	"github.com/nikunjkothiya/gopdfconv/pkg/errors"
+)
+
+// Router picks an Engine for a given input file based on its extension and
+// a caller-supplied preference order, so callers of Convert don't have to
+// route every extension to LibreOffice themselves.
+type Router struct {
+	engines    []Engine
+	preference []string
+}
+
+// NewRouter creates a Router over engines. preference lists engine Name()s
+// in the order they should be tried for an extension that more than one
+// engine supports; engines not listed are tried afterwards in the order
+// they were passed in.
+func NewRouter(preference []string, engines ...Engine) *Router {
+	return &Router{engines: engines, preference: preference}
+}
+
+// Select returns the best Engine for ext according to the router's
+// preference order, or an error if no registered engine supports it.
+func (r *Router) Select(ext string) (Engine, error) {
+	ext = strings.ToLower(ext)
+
+	for _, name := range r.preference {
+		for _, e := range r.engines {
+			if e.Name() == name && e.Supports(ext) {
+				return e, nil
+			}
+		}
+	}
+
+	for _, e := range r.engines {
+		if e.Supports(ext) {
+			return e, nil
+		}
+	}
+
+	return nil, errors.NewWithFile(errors.ErrConversionFailed, "no engine supports this file type", ext)
+}
+
+// Convert picks the best engine for inputPath's extension and converts it
+// to a PDF at outputPath.
+func (r *Router) Convert(inputPath, outputPath string) error {
+	engine, err := r.Select(filepath.Ext(inputPath))
+	if err != nil {
+		return err
+	}
+	return engine.Convert(inputPath, outputPath)
+}
+
+// ConvertTo picks the best engine for inputPath's extension and converts it
+// to outputPath in the given target format.
+func (r *Router) ConvertTo(inputPath, outputPath, format string) error {
+	engine, err := r.Select(filepath.Ext(inputPath))
+	if err != nil {
+		return err
+	}
+	return engine.ConvertTo(inputPath, outputPath, format)
+}
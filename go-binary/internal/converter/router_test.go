@@ -0,0 +1,61 @@
+package converter
+
+import "testing"
+
+// stubEngine is a minimal Engine for exercising Router.Select without
+// shelling out to any real conversion binary.
+type stubEngine struct {
+	name string
+	exts map[string]bool
+}
+
+func (e *stubEngine) Convert(inputPath, outputPath string) error           { return nil }
+func (e *stubEngine) ConvertTo(inputPath, outputPath, format string) error { return nil }
+func (e *stubEngine) Supports(ext string) bool                             { return e.exts[ext] }
+func (e *stubEngine) Name() string                                         { return e.name }
+
+var _ Engine = (*stubEngine)(nil)
+
+func TestRouter_Select_NoEngineSupports(t *testing.T) {
+	r := NewRouter(nil, &stubEngine{name: "a", exts: map[string]bool{".docx": true}})
+
+	if _, err := r.Select(".xyz"); err == nil {
+		t.Fatal("expected Select to fail for an unsupported extension")
+	}
+}
+
+func TestRouter_Select_FallsBackToRegistrationOrder(t *testing.T) {
+	first := &stubEngine{name: "first", exts: map[string]bool{".html": true}}
+	second := &stubEngine{name: "second", exts: map[string]bool{".html": true}}
+	r := NewRouter(nil, first, second)
+
+	got, err := r.Select(".html")
+	if err != nil {
+		t.Fatalf("Select returned error: %v", err)
+	}
+	if got != first {
+		t.Fatalf("Select = %s, want first registered engine when there is no preference", got.Name())
+	}
+}
+
+func TestRouter_Select_HonorsPreferenceOrder(t *testing.T) {
+	pandoc := &stubEngine{name: "pandoc", exts: map[string]bool{".html": true}}
+	wkhtmltopdf := &stubEngine{name: "wkhtmltopdf", exts: map[string]bool{".html": true}}
+	r := NewRouter([]string{"wkhtmltopdf", "pandoc"}, pandoc, wkhtmltopdf)
+
+	got, err := r.Select(".html")
+	if err != nil {
+		t.Fatalf("Select returned error: %v", err)
+	}
+	if got != wkhtmltopdf {
+		t.Fatalf("Select = %s, want wkhtmltopdf per preference order", got.Name())
+	}
+}
+
+func TestRouter_Select_IsCaseInsensitive(t *testing.T) {
+	r := NewRouter(nil, &stubEngine{name: "a", exts: map[string]bool{".docx": true}})
+
+	if _, err := r.Select(".DOCX"); err != nil {
+		t.Fatalf("Select(\".DOCX\") returned error: %v", err)
+	}
+}
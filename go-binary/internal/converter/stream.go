@@ -0,0 +1,114 @@
+package converter
+
+import (
+	"context"
+	"io"
+	"os"
+
+	"github.com/nikunjkothiya/gopdfconv/pkg/errors"
+)
+
+// streamResult wraps an open output file so Close both closes the file
+// handle and removes the temp input/output files it was staged through.
+type streamResult struct {
+	file  *os.File
+	paths []string
+}
+
+func (s *streamResult) Read(p []byte) (int, error) {
+	return s.file.Read(p)
+}
+
+func (s *streamResult) Close() error {
+	closeErr := s.file.Close()
+	for _, p := range s.paths {
+		os.Remove(p)
+	}
+	return closeErr
+}
+
+// stageToTempFile copies r into a new temp file named with the given
+// extension and returns its path.
+func stageToTempFile(r io.Reader, ext string) (string, error) {
+	tmp, err := os.CreateTemp("", "gopdfconv-in-*"+ext)
+	if err != nil {
+		return "", errors.Wrap(err, errors.ErrConversionFailed, "Failed to create temp input file")
+	}
+	defer tmp.Close()
+
+	if _, err := io.Copy(tmp, r); err != nil {
+		os.Remove(tmp.Name())
+		return "", errors.Wrap(err, errors.ErrConversionFailed, "Failed to stage upload to disk")
+	}
+
+	return tmp.Name(), nil
+}
+
+// ConvertStream stages r (an upload, typically a multipart file part) to a
+// temp file named with ext, converts it to PDF with opts applied, and
+// returns a ReadCloser over the result. Closing the returned ReadCloser
+// removes both the staged input and the converted output. ctx is passed
+// through to exec.CommandContext, so cancelling it (e.g. on client
+// disconnect) kills the in-flight soffice process instead of abandoning
+// it.
+//
+// This talks to LibreOffice directly, with no bound on how many
+// conversions run at once; an HTTP frontend fielding many uploads should
+// prefer ConverterPool.ConvertStream instead.
+func (c *LibreOfficeConverter) ConvertStream(ctx context.Context, r io.Reader, ext string, opts PDFOptions) (io.ReadCloser, error) {
+	inPath, err := stageToTempFile(r, ext)
+	if err != nil {
+		return nil, err
+	}
+
+	outPath := inPath + ".pdf"
+
+	if err := c.ConvertWithOptionsContext(ctx, inPath, outPath, opts); err != nil {
+		os.Remove(inPath)
+		return nil, err
+	}
+
+	out, err := os.Open(outPath)
+	if err != nil {
+		os.Remove(inPath)
+		os.Remove(outPath)
+		return nil, errors.Wrap(err, errors.ErrConversionFailed, "Failed to open converted PDF")
+	}
+
+	return &streamResult{file: out, paths: []string{inPath, outPath}}, nil
+}
+
+// ConvertStream stages r to a temp file and runs it through the pool,
+// respecting the same MaxWorkers/QueueDepth/PerJobTimeout bounds as
+// file-to-file conversions, instead of spawning an unbounded soffice
+// invocation per call. This is the path an HTTP frontend fielding many
+// concurrent uploads (see converter/httpx) should use.
+func (p *ConverterPool) ConvertStream(ctx context.Context, r io.Reader, ext string, opts PDFOptions) (io.ReadCloser, error) {
+	inPath, err := stageToTempFile(r, ext)
+	if err != nil {
+		return nil, err
+	}
+
+	outPath := inPath + ".pdf"
+
+	resultCh, err := p.SubmitWithOptions(ctx, inPath, outPath, opts)
+	if err != nil {
+		os.Remove(inPath)
+		return nil, err
+	}
+
+	result := <-resultCh
+	if result.Err != nil {
+		os.Remove(inPath)
+		return nil, result.Err
+	}
+
+	out, err := os.Open(outPath)
+	if err != nil {
+		os.Remove(inPath)
+		os.Remove(outPath)
+		return nil, errors.Wrap(err, errors.ErrConversionFailed, "Failed to open converted PDF")
+	}
+
+	return &streamResult{file: out, paths: []string{inPath, outPath}}, nil
+}
@@ -0,0 +1,66 @@
+package converter
+
+import (
+	"os"
+	"os/exec"
+	"strings"
+
+	"github.com/nikunjkothiya/gopdfconv/pkg/errors"
+)
+
+// WkhtmltopdfEngine converts HTML (a file path or a URL) to PDF using the
+// wkhtmltopdf binary. Unlike ChromedpEngine, it does not execute JavaScript.
+type WkhtmltopdfEngine struct {
+	binPath string
+}
+
+// NewWkhtmltopdfEngine creates a WkhtmltopdfEngine that invokes the
+// wkhtmltopdf binary at binPath.
+func NewWkhtmltopdfEngine(binPath string) *WkhtmltopdfEngine {
+	return &WkhtmltopdfEngine{binPath: binPath}
+}
+
+// Convert renders input (a local HTML file path or an http(s):// URL) to a
+// PDF at outputPath.
+func (e *WkhtmltopdfEngine) Convert(input, outputPath string) error {
+	if !isURL(input) {
+		if _, err := os.Stat(input); os.IsNotExist(err) {
+			return errors.NewWithFile(errors.ErrFileNotFound, "File not found", input)
+		}
+	}
+
+	cmd := exec.Command(e.binPath, "--quiet", input, outputPath)
+	output, err := cmd.CombinedOutput()
+	if err != nil {
+		return errors.NewWithDetails(errors.ErrConversionFailed, "wkhtmltopdf conversion failed", input, string(output))
+	}
+
+	return nil
+}
+
+// ConvertTo is provided to satisfy the Engine interface. wkhtmltopdf only
+// ever produces PDF output here, so format is ignored.
+func (e *WkhtmltopdfEngine) ConvertTo(input, outputPath, format string) error {
+	return e.Convert(input, outputPath)
+}
+
+// Supports reports whether wkhtmltopdf should handle the given extension.
+func (e *WkhtmltopdfEngine) Supports(ext string) bool {
+	switch strings.ToLower(ext) {
+	case ".html", ".htm":
+		return true
+	default:
+		return false
+	}
+}
+
+// Name identifies this engine as "wkhtmltopdf".
+func (e *WkhtmltopdfEngine) Name() string {
+	return "wkhtmltopdf"
+}
+
+func isURL(s string) bool {
+	return strings.HasPrefix(s, "http://") || strings.HasPrefix(s, "https://")
+}
+
+var _ Engine = (*WkhtmltopdfEngine)(nil)